@@ -16,18 +16,28 @@ limitations under the License.
 package resources
 
 import (
+	"container/list"
 	"context"
 	_ "embed" // needed for go:embed directive
+	"fmt"
+	"os"
 	"sort"
 	"strings"
+	"sync"
 
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	authorinov1beta2 "github.com/kuadrant/authorino/api/v1beta2"
 	"github.com/opendatahub-io/odh-model-controller/controllers/utils"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/yaml"
 )
 
 type AuthType string
@@ -35,8 +45,16 @@ type AuthType string
 const (
 	UserDefined AuthType = "userdefined"
 	Anonymous   AuthType = "anonymous"
+	// MTLS authenticates callers by their x509 client certificate.
+	MTLS AuthType = "mtls"
+	// JWT authenticates callers by a bearer token validated against a configurable issuer.
+	JWT AuthType = "jwt"
 )
 
+// authProfileAnnotation selects one of the AuthType values directly, taking precedence over the
+// legacy boolean enable-auth annotations so mtls/jwt profiles can be requested explicitly.
+const authProfileAnnotation = "security.opendatahub.io/auth-profile"
+
 type InferenceServiceHostExtractor interface {
 	Extract(isvc *kservev1beta1.InferenceService) []string
 }
@@ -51,6 +69,12 @@ type AuthTypeDetector interface {
 
 type AuthConfigStore interface {
 	Get(ctx context.Context, key types.NamespacedName) (*authorinov1beta2.AuthConfig, error)
+	// List returns every AuthConfig owned by the InferenceService with the given UID, using the
+	// informer-backed owner index rather than a namespace List+filter.
+	List(ctx context.Context, isvcUID types.UID) ([]*authorinov1beta2.AuthConfig, error)
+	// GetByHost returns the AuthConfig whose Spec.Hosts contains host, using the informer-backed
+	// host index. It returns nil, nil when no AuthConfig claims that host.
+	GetByHost(ctx context.Context, host string) (*authorinov1beta2.AuthConfig, error)
 	Remove(ctx context.Context, key types.NamespacedName) error
 	Create(ctx context.Context, authConfig *authorinov1beta2.AuthConfig) error
 	Update(ctx context.Context, authConfig *authorinov1beta2.AuthConfig) error
@@ -62,27 +86,185 @@ var authConfigTemplateAnonymous []byte
 //go:embed template/authconfig_userdefined.yaml
 var authConfigTemplateUserDefined []byte
 
+//go:embed template/authconfig_mtls.yaml
+var authConfigTemplateMTLS []byte
+
+//go:embed template/authconfig_jwt.yaml
+var authConfigTemplateJWT []byte
+
+const (
+	// jwtIssuerConfigMapSuffix names the companion ConfigMap (key.Name + this suffix, in
+	// key.Namespace) that staticTemplateLoader consults for JWT issuer parameters.
+	jwtIssuerConfigMapSuffix = "-jwt-issuer"
+	jwtIssuerURLKey          = "issuerUrl"
+	jwtAudiencesKey          = "audiences"
+	jwtJWKSURIKey            = "jwksUri"
+
+	// jwtIdentityName and jwtAudiencesAuthorizationName are the authentication/authorization map
+	// keys declared by template/authconfig_jwt.yaml.
+	jwtIdentityName               = "jwt-issuer"
+	jwtAudiencesAuthorizationName = "jwt-audiences"
+
+	// mtlsIdentityName is the authentication map key declared by template/authconfig_mtls.yaml.
+	mtlsIdentityName = "mtls-client-cert"
+
+	// inferenceServiceNameLabel is the label the MTLS x509 identity selector is pinned to,
+	// scoping it to client-cert Secrets labeled for one particular InferenceService.
+	inferenceServiceNameLabel = "security.opendatahub.io/inferenceservice-name"
+)
+
 type staticTemplateLoader struct {
+	client client.Client
 }
 
-func NewStaticTemplateLoader() AuthConfigTemplateLoader {
-	return &staticTemplateLoader{}
+// NewStaticTemplateLoader builds the loader for the embedded AuthConfig templates. client is
+// used to resolve the JWT issuer parameters ConfigMap referenced by the JWT template.
+func NewStaticTemplateLoader(client client.Client) AuthConfigTemplateLoader {
+	return &staticTemplateLoader{client: client}
 }
 
 func (s *staticTemplateLoader) Load(ctx context.Context, authType AuthType, key types.NamespacedName) (authorinov1beta2.AuthConfig, error) {
 	authConfig := authorinov1beta2.AuthConfig{}
-	if authType == UserDefined {
-		err := utils.ConvertToStructuredResource(authConfigTemplateUserDefined, &authConfig)
-		if err != nil {
+	switch authType {
+	case UserDefined:
+		if err := utils.ConvertToStructuredResource(authConfigTemplateUserDefined, &authConfig); err != nil {
 			return authConfig, errors.Wrap(err, "could not load UserDefined template")
 		}
 		return authConfig, nil
+	case MTLS:
+		if err := utils.ConvertToStructuredResource(authConfigTemplateMTLS, &authConfig); err != nil {
+			return authConfig, errors.Wrap(err, "could not load MTLS template")
+		}
+		setMTLSLabelSelector(&authConfig, key)
+		return authConfig, nil
+	case JWT:
+		if err := utils.ConvertToStructuredResource(authConfigTemplateJWT, &authConfig); err != nil {
+			return authConfig, errors.Wrap(err, "could not load JWT template")
+		}
+		if err := s.setJWTIssuerParams(ctx, &authConfig, key); err != nil {
+			return authConfig, err
+		}
+		return authConfig, nil
+	default:
+		if err := utils.ConvertToStructuredResource(authConfigTemplateAnonymous, &authConfig); err != nil {
+			return authConfig, errors.Wrap(err, "could not load Anonymous template")
+		}
+		return authConfig, nil
+	}
+}
+
+// setMTLSLabelSelector points the mtls-client-cert x509 identity source's label selector at
+// key.Name, so it only matches client-cert Secrets labeled for this particular InferenceService.
+// It assigns key.Name onto the already-parsed typed struct rather than splicing it into YAML
+// text, so a namespace/name containing characters such as "\n" or ": " can never be reinterpreted
+// as YAML structure.
+func setMTLSLabelSelector(authConfig *authorinov1beta2.AuthConfig, key types.NamespacedName) {
+	identity := authConfig.Spec.Authentication[mtlsIdentityName]
+	if identity.X509 == nil {
+		return
+	}
+	if identity.X509.Selector == nil {
+		identity.X509.Selector = &v1.LabelSelector{}
 	}
-	err := utils.ConvertToStructuredResource(authConfigTemplateAnonymous, &authConfig)
+	if identity.X509.Selector.MatchLabels == nil {
+		identity.X509.Selector.MatchLabels = map[string]string{}
+	}
+	identity.X509.Selector.MatchLabels[inferenceServiceNameLabel] = key.Name
+	authConfig.Spec.Authentication[mtlsIdentityName] = identity
+}
+
+// setJWTIssuerParams fills in the jwt-issuer identity source and jwt-audiences authorization
+// policy from the key.Name+jwtIssuerConfigMapSuffix ConfigMap in key.Namespace, by assigning
+// values onto the already-parsed typed struct. A missing ConfigMap is read as one with no data,
+// so, like one without audiences, it makes setJWTIssuerParams return an error. Whatever an operator puts in that
+// ConfigMap - including a value containing ": " or a newline - becomes a Go string field, never
+// text concatenated into YAML ahead of parsing, so it can't rewrite a sibling key or add a rule.
+func (s *staticTemplateLoader) setJWTIssuerParams(ctx context.Context, authConfig *authorinov1beta2.AuthConfig, key types.NamespacedName) error {
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Name: key.Name + jwtIssuerConfigMapSuffix, Namespace: key.Namespace}
+	if err := s.client.Get(ctx, cmKey, cm); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return errors.Wrapf(err, "could not GET configmap %s", cmKey)
+		}
+		cm = &corev1.ConfigMap{}
+	}
+
+	identity := authConfig.Spec.Authentication[jwtIdentityName]
+	if identity.Jwt == nil {
+		identity.Jwt = &authorinov1beta2.JwtAuthenticationSpec{}
+	}
+	identity.Jwt.IssuerUrl = cm.Data[jwtIssuerURLKey]
+	identity.Jwt.JwksUri = cm.Data[jwtJWKSURIKey]
+	authConfig.Spec.Authentication[jwtIdentityName] = identity
+
+	patterns, err := audiencePatterns(cm.Data[jwtAudiencesKey])
 	if err != nil {
-		return authConfig, errors.Wrap(err, "could not load Anonymous template")
+		return errors.Wrapf(err, "configmap %s", cmKey)
 	}
-	return authConfig, nil
+
+	authorization := authConfig.Spec.Authorization[jwtAudiencesAuthorizationName]
+	if authorization.PatternMatching == nil {
+		authorization.PatternMatching = &authorinov1beta2.PatternMatchingAuthorizationSpec{}
+	}
+	authorization.PatternMatching.Patterns = patterns
+	authConfig.Spec.Authorization[jwtAudiencesAuthorizationName] = authorization
+	return nil
+}
+
+// audiencePatterns turns a comma-separated list of audiences (e.g. "aud1, aud2") into a single
+// "any" pattern-matching expression, one inclusion check per audience, so a token matching any
+// one of the configured audiences is accepted - a single comma-joined string would only ever
+// match a token whose literal aud claim was that whole string.
+//
+// It fails closed: a ConfigMap that doesn't configure any audiences is a misconfiguration, not
+// "accept every issuer's tokens", so callers get an error instead of an authorization rule that
+// silently never rejects a token.
+func audiencePatterns(rawAudiences string) ([]authorinov1beta2.PatternExpressionOrRef, error) {
+	var audiences []string
+	for _, audience := range strings.Split(rawAudiences, ",") {
+		if audience = strings.TrimSpace(audience); audience != "" {
+			audiences = append(audiences, audience)
+		}
+	}
+	if len(audiences) == 0 {
+		return nil, fmt.Errorf("%s key is not set; at least one audience is required", jwtAudiencesKey)
+	}
+
+	any := make([]authorinov1beta2.PatternExpressionOrRef, len(audiences))
+	for i, audience := range audiences {
+		any[i] = authorinov1beta2.PatternExpressionOrRef{
+			PatternExpression: authorinov1beta2.PatternExpression{
+				Selector: "auth.identity.aud",
+				Operator: "incl",
+				Value:    audience,
+			},
+		}
+	}
+	return []authorinov1beta2.PatternExpressionOrRef{{Any: any}}, nil
+}
+
+const (
+	// authConfigTemplateConfigMapName is the name of the namespace-local ConfigMap that,
+	// when present, supplies overrides to be merged onto the embedded AuthConfig templates.
+	authConfigTemplateConfigMapName = "authconfig-template"
+
+	// authConfigTemplateSpecKey is the ConfigMap data key holding the YAML-encoded overrides.
+	authConfigTemplateSpecKey = "spec"
+
+	// authConfigTemplateDefaultsNamespaceEnv optionally names a cluster-scoped namespace that
+	// is consulted for an authconfig-template ConfigMap when the InferenceService namespace
+	// does not have its own override.
+	authConfigTemplateDefaultsNamespaceEnv = "AUTHCONFIG_TEMPLATE_DEFAULTS_NAMESPACE"
+)
+
+// authConfigTemplateOverrides is the expected shape of the "spec" key in an authconfig-template
+// ConfigMap: a fragment of AuthConfigSpec keyed by AuthType, so each AuthType's template can be
+// customized independently from the same ConfigMap.
+type authConfigTemplateOverrides struct {
+	UserDefined map[string]interface{} `json:"userdefined,omitempty"`
+	Anonymous   map[string]interface{} `json:"anonymous,omitempty"`
+	MTLS        map[string]interface{} `json:"mtls,omitempty"`
+	JWT         map[string]interface{} `json:"jwt,omitempty"`
 }
 
 type configMapTemplateLoader struct {
@@ -98,11 +280,184 @@ func NewConfigMapTemplateLoader(client client.Client, fallback AuthConfigTemplat
 }
 
 func (c *configMapTemplateLoader) Load(ctx context.Context, authType AuthType, key types.NamespacedName) (authorinov1beta2.AuthConfig, error) {
-	// TOOD: check "authconfig-template" CM in key.Namespace to see if there is a "spec" to use, construct a AuthConfig object
-	// https://issues.redhat.com/browse/RHOAIENG-847
+	authConfig, err := c.fallback.Load(ctx, authType, key)
+	if err != nil {
+		return authConfig, err
+	}
+
+	override, err := c.loadOverride(ctx, key.Namespace, authType)
+	if err != nil {
+		return authConfig, errors.Wrapf(err, "could not load %s override for %s", authConfigTemplateConfigMapName, key)
+	}
+	if override == nil {
+		return authConfig, nil
+	}
+
+	mergedSpec, err := mergeAuthConfigSpec(authConfig.Spec, override)
+	if err != nil {
+		return authConfig, errors.Wrapf(err, "could not merge %s override onto %s template for %s", authConfigTemplateConfigMapName, authType, key)
+	}
+	authConfig.Spec = mergedSpec
+	return authConfig, nil
+}
 
-	// else
-	return c.fallback.Load(ctx, authType, key)
+// loadOverride looks up the authconfig-template ConfigMap, first in namespace, then - if it
+// does not exist there - in the cluster-scoped defaults namespace configured through
+// AUTHCONFIG_TEMPLATE_DEFAULTS_NAMESPACE, if any. It returns nil, nil when no override applies.
+func (c *configMapTemplateLoader) loadOverride(ctx context.Context, namespace string, authType AuthType) (map[string]interface{}, error) {
+	overrides, err := c.readOverrides(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if overrides == nil {
+		if defaultsNamespace := os.Getenv(authConfigTemplateDefaultsNamespaceEnv); defaultsNamespace != "" && defaultsNamespace != namespace {
+			overrides, err = c.readOverrides(ctx, defaultsNamespace)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if overrides == nil {
+		return nil, nil
+	}
+
+	switch authType {
+	case UserDefined:
+		return overrides.UserDefined, nil
+	case Anonymous:
+		return overrides.Anonymous, nil
+	case MTLS:
+		return overrides.MTLS, nil
+	case JWT:
+		return overrides.JWT, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (c *configMapTemplateLoader) readOverrides(ctx context.Context, namespace string) (*authConfigTemplateOverrides, error) {
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Name: authConfigTemplateConfigMapName, Namespace: namespace}
+	if err := c.client.Get(ctx, cmKey, cm); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "could not GET configmap %s", cmKey)
+	}
+
+	specYaml, exist := cm.Data[authConfigTemplateSpecKey]
+	if !exist || strings.TrimSpace(specYaml) == "" {
+		return nil, nil
+	}
+
+	overrides := &authConfigTemplateOverrides{}
+	if err := yaml.Unmarshal([]byte(specYaml), overrides); err != nil {
+		return nil, errors.Wrapf(err, "could not parse %q key of configmap %s as AuthConfig overrides", authConfigTemplateSpecKey, cmKey)
+	}
+	return overrides, nil
+}
+
+// mergeAuthConfigSpec deep-merges override onto base, preserving every entry already present in
+// base (e.g. the Kubernetes TokenReview identity source shipped with the embedded templates)
+// while letting override add or replace individual keys (e.g. an additional identity source,
+// authorization policy, metadata fetcher, or response transformation).
+func mergeAuthConfigSpec(base authorinov1beta2.AuthConfigSpec, override map[string]interface{}) (authorinov1beta2.AuthConfigSpec, error) {
+	merged := authorinov1beta2.AuthConfigSpec{}
+
+	baseJson, err := yaml.Marshal(base)
+	if err != nil {
+		return merged, errors.Wrap(err, "could not marshal base AuthConfigSpec")
+	}
+	baseMap := map[string]interface{}{}
+	if err := yaml.Unmarshal(baseJson, &baseMap); err != nil {
+		return merged, errors.Wrap(err, "could not decode base AuthConfigSpec")
+	}
+
+	mergedMap := deepMergeMaps(baseMap, override)
+
+	mergedJson, err := yaml.Marshal(mergedMap)
+	if err != nil {
+		return merged, errors.Wrap(err, "could not marshal merged AuthConfigSpec")
+	}
+	if err := yaml.Unmarshal(mergedJson, &merged); err != nil {
+		return merged, errors.Wrap(err, "could not decode merged AuthConfigSpec")
+	}
+	return merged, nil
+}
+
+// deepMergeMaps returns a new map containing every entry of base, with every entry of override
+// recursively merged in on top - nested maps are merged key-by-key, anything else is replaced.
+func deepMergeMaps(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, overrideVal := range override {
+		if baseVal, exist := merged[k]; exist {
+			baseMap, baseIsMap := baseVal.(map[string]interface{})
+			overrideMap, overrideIsMap := overrideVal.(map[string]interface{})
+			if baseIsMap && overrideIsMap {
+				merged[k] = deepMergeMaps(baseMap, overrideMap)
+				continue
+			}
+		}
+		merged[k] = overrideVal
+	}
+	return merged
+}
+
+const (
+	// AuthConfigOwnerIndexField indexes AuthConfig objects by the UID of the InferenceService
+	// that owns them, so clientAuthConfigStore.List can use the manager's informer cache
+	// instead of a namespace List+filter on every reconcile.
+	AuthConfigOwnerIndexField = "authconfig.opendatahub.io/owner-isvc-uid"
+
+	// AuthConfigHostIndexField indexes AuthConfig objects by each entry of Spec.Hosts, backing
+	// clientAuthConfigStore.GetByHost.
+	AuthConfigHostIndexField = "authconfig.opendatahub.io/host"
+)
+
+// RegisterAuthConfigIndexes wires the field indexes clientAuthConfigStore relies on onto the
+// manager's cache. It must be called once, before the cache starts, typically from the owning
+// reconciler's SetupWithManager.
+func RegisterAuthConfigIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &authorinov1beta2.AuthConfig{}, AuthConfigOwnerIndexField, indexAuthConfigByOwnerIsvcUID); err != nil {
+		return errors.Wrap(err, "could not index AuthConfig by owner InferenceService UID")
+	}
+	if err := indexer.IndexField(ctx, &authorinov1beta2.AuthConfig{}, AuthConfigHostIndexField, indexAuthConfigByHost); err != nil {
+		return errors.Wrap(err, "could not index AuthConfig by host")
+	}
+	return nil
+}
+
+func indexAuthConfigByOwnerIsvcUID(obj client.Object) []string {
+	authConfig, ok := obj.(*authorinov1beta2.AuthConfig)
+	if !ok {
+		return nil
+	}
+	var uids []string
+	for _, ref := range authConfig.GetOwnerReferences() {
+		if ref.Kind == "InferenceService" {
+			uids = append(uids, string(ref.UID))
+		}
+	}
+	return uids
+}
+
+func indexAuthConfigByHost(obj client.Object) []string {
+	authConfig, ok := obj.(*authorinov1beta2.AuthConfig)
+	if !ok {
+		return nil
+	}
+	return authConfig.Spec.Hosts
+}
+
+// NewAuthConfigEnqueueHandler builds the event handler that should be passed to a
+// ctrl.Builder.Watches call for &authorinov1beta2.AuthConfig{} so that an AuthConfig drifting
+// externally (edited or deleted out-of-band) causes its owning InferenceService to be
+// re-reconciled. scheme and restMapper are the manager's, e.g. mgr.GetScheme()/mgr.GetRESTMapper().
+func NewAuthConfigEnqueueHandler(scheme *runtime.Scheme, restMapper apimeta.RESTMapper) handler.EventHandler {
+	return handler.EnqueueRequestForOwner(scheme, restMapper, &kservev1beta1.InferenceService{})
 }
 
 type clientAuthConfigStore struct {
@@ -130,6 +485,29 @@ func (c *clientAuthConfigStore) Get(ctx context.Context, key types.NamespacedNam
 	return authConfig, nil
 }
 
+func (c *clientAuthConfigStore) List(ctx context.Context, isvcUID types.UID) ([]*authorinov1beta2.AuthConfig, error) {
+	list := &authorinov1beta2.AuthConfigList{}
+	if err := c.client.List(ctx, list, client.MatchingFields{AuthConfigOwnerIndexField: string(isvcUID)}); err != nil {
+		return nil, errors.Wrapf(err, "could not LIST authconfigs for InferenceService UID %s", isvcUID)
+	}
+	authConfigs := make([]*authorinov1beta2.AuthConfig, len(list.Items))
+	for i := range list.Items {
+		authConfigs[i] = &list.Items[i]
+	}
+	return authConfigs, nil
+}
+
+func (c *clientAuthConfigStore) GetByHost(ctx context.Context, host string) (*authorinov1beta2.AuthConfig, error) {
+	list := &authorinov1beta2.AuthConfigList{}
+	if err := c.client.List(ctx, list, client.MatchingFields{AuthConfigHostIndexField: host}); err != nil {
+		return nil, errors.Wrapf(err, "could not LIST authconfigs for host %s", host)
+	}
+	if len(list.Items) == 0 {
+		return nil, nil
+	}
+	return &list.Items[0], nil
+}
+
 func (c *clientAuthConfigStore) Remove(ctx context.Context, key types.NamespacedName) error {
 	authConfig := authorinov1beta2.AuthConfig{}
 	authConfig.Name = key.Name
@@ -156,11 +534,21 @@ func NewKServeAuthTypeDetector(client client.Client) AuthTypeDetector {
 }
 
 func (k *kserveAuthTypeDetector) Detect(ctx context.Context, isvc *kservev1beta1.InferenceService) (AuthType, error) {
+	if profile, exist := isvc.Annotations[authProfileAnnotation]; exist {
+		switch AuthType(strings.ToLower(profile)) {
+		case Anonymous, UserDefined, MTLS, JWT:
+			return AuthType(strings.ToLower(profile)), nil
+		default:
+			return Anonymous, errors.Errorf("invalid %s annotation value %q, expected one of anonymous|userdefined|mtls|jwt", authProfileAnnotation, profile)
+		}
+	}
+
+	// legacy boolean annotations, preserved for backward compatibility when auth-profile is unset
 	if value, exist := isvc.Annotations["security.opendatahub.io/enable-auth"]; exist {
 		if strings.ToLower(value) == "true" {
 			return UserDefined, nil
 		}
-	} else { // backward compat
+	} else {
 		if strings.ToLower(isvc.Annotations["enable-auth"]) == "true" {
 			return UserDefined, nil
 		}
@@ -168,14 +556,36 @@ func (k *kserveAuthTypeDetector) Detect(ctx context.Context, isvc *kservev1beta1
 	return Anonymous, nil
 }
 
+// hostCacheMaxEntries bounds kserveInferenceServiceHostExtractor's cache so that it cannot grow
+// without limit over the controller's lifetime; once full, the least-recently-used ISVC entry is
+// evicted to make room (covering deleted ISVCs too, since those simply stop being looked up).
+const hostCacheMaxEntries = 4096
+
 type kserveInferenceServiceHostExtractor struct {
+	mu    sync.Mutex
+	cache map[types.UID]*list.Element // ISVC UID -> element of order, Value is *hostCacheEntry
+	order *list.List                  // front = most recently used
+}
+
+type hostCacheEntry struct {
+	uid             types.UID
+	resourceVersion string
+	hosts           []string
 }
 
 func NewKServeInferenceServiceHostExtractor() InferenceServiceHostExtractor {
-	return &kserveInferenceServiceHostExtractor{}
+	return &kserveInferenceServiceHostExtractor{
+		cache: map[types.UID]*list.Element{},
+		order: list.New(),
+	}
 }
 
 func (k *kserveInferenceServiceHostExtractor) Extract(isvc *kservev1beta1.InferenceService) []string {
+	if isvc.UID != "" && isvc.ResourceVersion != "" {
+		if hosts, ok := k.lookup(isvc.UID, isvc.ResourceVersion); ok {
+			return hosts
+		}
+	}
 
 	hosts := k.findAllURLHosts(isvc)
 
@@ -186,9 +596,49 @@ func (k *kserveInferenceServiceHostExtractor) Extract(isvc *kservev1beta1.Infere
 		}
 	}
 	sort.Strings(hosts)
+
+	if isvc.UID != "" && isvc.ResourceVersion != "" {
+		k.store(isvc.UID, isvc.ResourceVersion, hosts)
+	}
 	return hosts
 }
 
+func (k *kserveInferenceServiceHostExtractor) lookup(uid types.UID, resourceVersion string) ([]string, bool) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	elem, ok := k.cache[uid]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*hostCacheEntry)
+	if entry.resourceVersion != resourceVersion {
+		return nil, false
+	}
+	k.order.MoveToFront(elem)
+	return entry.hosts, true
+}
+
+func (k *kserveInferenceServiceHostExtractor) store(uid types.UID, resourceVersion string, hosts []string) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if elem, ok := k.cache[uid]; ok {
+		k.order.Remove(elem)
+	}
+	elem := k.order.PushFront(&hostCacheEntry{uid: uid, resourceVersion: resourceVersion, hosts: hosts})
+	k.cache[uid] = elem
+
+	for k.order.Len() > hostCacheMaxEntries {
+		oldest := k.order.Back()
+		if oldest == nil {
+			break
+		}
+		k.order.Remove(oldest)
+		delete(k.cache, oldest.Value.(*hostCacheEntry).uid)
+	}
+}
+
 func (k *kserveInferenceServiceHostExtractor) findAllURLHosts(isvc *kservev1beta1.InferenceService) []string {
 	hosts := []string{}
 
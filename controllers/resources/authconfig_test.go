@@ -0,0 +1,274 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	authorinov1beta2 "github.com/kuadrant/authorino/api/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func newFakeClientWithConfigMap(t *testing.T, cm *corev1.ConfigMap) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("could not register corev1 scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+}
+
+func mustUnmarshalSpec(t *testing.T, y string) authorinov1beta2.AuthConfigSpec {
+	t.Helper()
+	spec := authorinov1beta2.AuthConfigSpec{}
+	if err := yaml.Unmarshal([]byte(y), &spec); err != nil {
+		t.Fatalf("could not unmarshal AuthConfigSpec: %v", err)
+	}
+	return spec
+}
+
+func TestMergeAuthConfigSpec_AddsIdentitySourceWhilePreservingExisting(t *testing.T) {
+	base := mustUnmarshalSpec(t, `
+hosts:
+  - placeholder
+authentication:
+  kubernetes-user:
+    kubernetesTokenReview:
+      audiences:
+        - placeholder
+`)
+
+	override := map[string]interface{}{
+		"authentication": map[string]interface{}{
+			"api-key-users": map[string]interface{}{
+				"apiKey": map[string]interface{}{
+					"selector": map[string]interface{}{
+						"matchLabels": map[string]interface{}{
+							"authorino.kuadrant.io/managed-by": "authorino",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	merged, err := mergeAuthConfigSpec(base, override)
+	if err != nil {
+		t.Fatalf("mergeAuthConfigSpec returned error: %v", err)
+	}
+
+	if len(merged.Authentication) != 2 {
+		t.Fatalf("expected 2 identity sources after merge, got %d: %#v", len(merged.Authentication), merged.Authentication)
+	}
+	if _, exist := merged.Authentication["kubernetes-user"]; !exist {
+		t.Fatalf("expected original kubernetes-user identity source to be preserved, got %#v", merged.Authentication)
+	}
+	if _, exist := merged.Authentication["api-key-users"]; !exist {
+		t.Fatalf("expected api-key-users identity source to be added, got %#v", merged.Authentication)
+	}
+}
+
+func TestMergeAuthConfigSpec_OverrideReplacesSameKey(t *testing.T) {
+	base := mustUnmarshalSpec(t, `
+hosts:
+  - placeholder
+authentication:
+  kubernetes-user:
+    kubernetesTokenReview:
+      audiences:
+        - placeholder
+`)
+
+	override := map[string]interface{}{
+		"authentication": map[string]interface{}{
+			"kubernetes-user": map[string]interface{}{
+				"kubernetesTokenReview": map[string]interface{}{
+					"audiences": []interface{}{"overridden"},
+				},
+			},
+		},
+	}
+
+	merged, err := mergeAuthConfigSpec(base, override)
+	if err != nil {
+		t.Fatalf("mergeAuthConfigSpec returned error: %v", err)
+	}
+
+	identity, exist := merged.Authentication["kubernetes-user"]
+	if !exist {
+		t.Fatalf("expected kubernetes-user identity source to remain present, got %#v", merged.Authentication)
+	}
+	if identity.KubernetesTokenReview == nil || len(identity.KubernetesTokenReview.Audiences) != 1 || identity.KubernetesTokenReview.Audiences[0] != "overridden" {
+		t.Fatalf("expected kubernetesTokenReview.audiences to be overridden, got %#v", identity.KubernetesTokenReview)
+	}
+}
+
+func TestDeepMergeMaps(t *testing.T) {
+	base := map[string]interface{}{
+		"authentication": map[string]interface{}{
+			"a": map[string]interface{}{"x": 1},
+		},
+		"hosts": []interface{}{"old"},
+	}
+	override := map[string]interface{}{
+		"authentication": map[string]interface{}{
+			"b": map[string]interface{}{"y": 2},
+		},
+		"hosts": []interface{}{"new"},
+	}
+
+	merged := deepMergeMaps(base, override)
+
+	authMap, ok := merged["authentication"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected authentication to remain a map, got %#v", merged["authentication"])
+	}
+	if _, exist := authMap["a"]; !exist {
+		t.Fatalf("expected key %q to be preserved from base, got %#v", "a", authMap)
+	}
+	if _, exist := authMap["b"]; !exist {
+		t.Fatalf("expected key %q to be added from override, got %#v", "b", authMap)
+	}
+
+	hosts, ok := merged["hosts"].([]interface{})
+	if !ok || len(hosts) != 1 || hosts[0] != "new" {
+		t.Fatalf("expected non-map override value to fully replace the base value, got %#v", merged["hosts"])
+	}
+
+	// base must not be mutated by the merge
+	if _, exist := base["authentication"].(map[string]interface{})["b"]; exist {
+		t.Fatalf("deepMergeMaps must not mutate base, but found %q in base", "b")
+	}
+}
+
+// TestStaticTemplateLoader_JWT_MaliciousIssuerConfigCannotAlterOtherFields pins down that
+// setJWTIssuerParams assigns issuer config onto the typed struct rather than splicing it into
+// YAML text: values crafted to look like YAML (a newline, a "key: value" pair) must land as the
+// literal contents of a single string/Value field, not rewrite Spec.Hosts or add an extra
+// identity/authorization entry.
+func TestStaticTemplateLoader_JWT_MaliciousIssuerConfigCannotAlterOtherFields(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-isvc-jwt-issuer", Namespace: "ns"},
+		Data: map[string]string{
+			jwtIssuerURLKey: "https://evil.example.com\nauthorization:\n  extra: {}",
+			jwtJWKSURIKey:   "https://evil.example.com/jwks\nhosts: [\"attacker.example.com\"]",
+			jwtAudiencesKey: "aud1: not-a-real-audience\nhosts",
+		},
+	}
+	loader := NewStaticTemplateLoader(newFakeClientWithConfigMap(t, cm))
+
+	authConfig, err := loader.Load(context.Background(), JWT, types.NamespacedName{Name: "my-isvc", Namespace: "ns"})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if len(authConfig.Spec.Authentication) != 1 {
+		t.Fatalf("expected exactly one identity source, got %#v", authConfig.Spec.Authentication)
+	}
+	if len(authConfig.Spec.Authorization) != 1 {
+		t.Fatalf("expected exactly one authorization policy, got %#v", authConfig.Spec.Authorization)
+	}
+	if len(authConfig.Spec.Hosts) != 1 || authConfig.Spec.Hosts[0] != "placeholder" {
+		t.Fatalf("a malicious config value altered Spec.Hosts, got %#v", authConfig.Spec.Hosts)
+	}
+
+	identity := authConfig.Spec.Authentication[jwtIdentityName]
+	if identity.Jwt == nil || identity.Jwt.IssuerUrl != cm.Data[jwtIssuerURLKey] {
+		t.Fatalf("expected IssuerUrl to be set verbatim as data, got %#v", identity.Jwt)
+	}
+	if identity.Jwt.JwksUri != cm.Data[jwtJWKSURIKey] {
+		t.Fatalf("expected JwksUri to be set verbatim as data, got %q", identity.Jwt.JwksUri)
+	}
+
+	authorization := authConfig.Spec.Authorization[jwtAudiencesAuthorizationName]
+	if authorization.PatternMatching == nil || len(authorization.PatternMatching.Patterns) != 1 {
+		t.Fatalf("expected exactly one any-grouped pattern, got %#v", authorization.PatternMatching)
+	}
+	any := authorization.PatternMatching.Patterns[0].Any
+	if len(any) != 1 || any[0].Value != cm.Data[jwtAudiencesKey] {
+		t.Fatalf("expected the whole malicious audiences value to be treated as one literal audience, got %#v", any)
+	}
+}
+
+// TestStaticTemplateLoader_MTLS_MaliciousISVCNameCannotAlterOtherFields mirrors the JWT case
+// above for setMTLSLabelSelector: an InferenceService name crafted to look like YAML must end up
+// as a literal label value, not alter Spec.Hosts or any other field.
+func TestStaticTemplateLoader_MTLS_MaliciousISVCNameCannotAlterOtherFields(t *testing.T) {
+	loader := NewStaticTemplateLoader(newFakeClientWithConfigMap(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "placeholder", Namespace: "ns"},
+	}))
+	maliciousName := "evil\nhosts: [\"attacker.example.com\"]"
+
+	authConfig, err := loader.Load(context.Background(), MTLS, types.NamespacedName{Name: maliciousName, Namespace: "ns"})
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if len(authConfig.Spec.Hosts) != 1 || authConfig.Spec.Hosts[0] != "placeholder" {
+		t.Fatalf("a malicious ISVC name altered Spec.Hosts, got %#v", authConfig.Spec.Hosts)
+	}
+
+	identity := authConfig.Spec.Authentication[mtlsIdentityName]
+	if identity.X509 == nil || identity.X509.Selector == nil {
+		t.Fatalf("expected x509 selector to be set, got %#v", identity)
+	}
+	if identity.X509.Selector.MatchLabels[inferenceServiceNameLabel] != maliciousName {
+		t.Fatalf("expected the label value to be set verbatim as data, got %#v", identity.X509.Selector.MatchLabels)
+	}
+}
+
+// TestAudiencePatterns_NoAudiencesConfigured_FailsClosed pins down that a <isvc-name>-jwt-issuer
+// ConfigMap with no (or blank) audiences key is treated as a misconfiguration and rejected,
+// rather than silently producing an authorization rule that never rejects a token.
+func TestAudiencePatterns_NoAudiencesConfigured_FailsClosed(t *testing.T) {
+	if _, err := audiencePatterns(""); err == nil {
+		t.Fatalf("expected an error when no audiences are configured, got nil")
+	}
+	if _, err := audiencePatterns("   "); err == nil {
+		t.Fatalf("expected an error when audiences is whitespace-only, got nil")
+	}
+}
+
+// TestAudiencePatterns_OneInclusionCheckPerAudience pins down that a comma-separated audience
+// list produces one "incl" pattern per audience grouped under a single "any", matching a token
+// whose aud claim includes any one of them - not one pattern that matches only the literal
+// comma-joined scalar.
+func TestAudiencePatterns_OneInclusionCheckPerAudience(t *testing.T) {
+	patterns, err := audiencePatterns(" aud1 , aud2 ")
+	if err != nil {
+		t.Fatalf("audiencePatterns returned error: %v", err)
+	}
+	if len(patterns) != 1 {
+		t.Fatalf("expected a single any-grouped pattern, got %d: %#v", len(patterns), patterns)
+	}
+
+	any := patterns[0].Any
+	if len(any) != 2 {
+		t.Fatalf("expected one inclusion check per audience, got %d: %#v", len(any), any)
+	}
+	for i, wantAudience := range []string{"aud1", "aud2"} {
+		if any[i].Selector != "auth.identity.aud" || any[i].Operator != "incl" || any[i].Value != wantAudience {
+			t.Fatalf("pattern %d = %#v, want selector auth.identity.aud / operator incl / value %q", i, any[i], wantAudience)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/opendatahub-io/odh-model-controller/controllers/resources"
+	"github.com/pkg/errors"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	enableAuthAnnotation       = "security.opendatahub.io/enable-auth"
+	legacyEnableAuthAnnotation = "enable-auth" // backward compat
+)
+
+// authorinoAuthConfigGK is the GroupKind that must be served by the cluster's RESTMapper for
+// auth to be enforceable; its absence means Authorino is not installed.
+var authorinoAuthConfigGK = schema.GroupKind{Group: "authorino.kuadrant.io", Kind: "AuthConfig"}
+
+// InferenceServiceValidator validates the `security.opendatahub.io/enable-auth` (and legacy
+// `enable-auth`) annotations and the `security.opendatahub.io/auth-profile` annotation on an
+// InferenceService before kserveAuthTypeDetector.Detect is relied on elsewhere, so that a
+// malformed or impossible-to-satisfy auth request is rejected at admission time rather than
+// surfacing later as a reconcile error.
+type InferenceServiceValidator struct {
+	client           client.Client
+	authTypeDetector resources.AuthTypeDetector
+	templateLoader   resources.AuthConfigTemplateLoader
+}
+
+var _ admission.CustomValidator = &InferenceServiceValidator{}
+
+// NewInferenceServiceValidator builds an InferenceServiceValidator. authTypeDetector is used to
+// resolve the InferenceService's actual AuthType (pass resources.NewKServeAuthTypeDetector(client)).
+// templateLoader is used to confirm that any authconfig-template ConfigMap referenced by the
+// target namespace parses cleanly for that AuthType; pass
+// resources.NewConfigMapTemplateLoader(client, resources.NewStaticTemplateLoader(client)).
+// The template check is skipped when the AuthType is Anonymous.
+func NewInferenceServiceValidator(client client.Client, authTypeDetector resources.AuthTypeDetector, templateLoader resources.AuthConfigTemplateLoader) *InferenceServiceValidator {
+	return &InferenceServiceValidator{
+		client:           client,
+		authTypeDetector: authTypeDetector,
+		templateLoader:   templateLoader,
+	}
+}
+
+// SetupWebhookWithManager registers this validator against kservev1beta1.InferenceService,
+// wiring certificate management through controller-runtime's webhook server.
+func (v *InferenceServiceValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&kservev1beta1.InferenceService{}).
+		WithValidator(v).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-serving-kserve-io-v1beta1-inferenceservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=vinferenceservice.odh-model-controller.opendatahub.io,admissionReviewVersions=v1
+
+func (v *InferenceServiceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	isvc, err := asInferenceService(obj)
+	if err != nil {
+		return nil, err
+	}
+	return v.validate(ctx, isvc)
+}
+
+func (v *InferenceServiceValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	isvc, err := asInferenceService(newObj)
+	if err != nil {
+		return nil, err
+	}
+	return v.validate(ctx, isvc)
+}
+
+func (v *InferenceServiceValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func asInferenceService(obj runtime.Object) (*kservev1beta1.InferenceService, error) {
+	isvc, ok := obj.(*kservev1beta1.InferenceService)
+	if !ok {
+		return nil, fmt.Errorf("expected an InferenceService but got a %T", obj)
+	}
+	return isvc, nil
+}
+
+func (v *InferenceServiceValidator) validate(ctx context.Context, isvc *kservev1beta1.InferenceService) (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	value, exist := isvc.Annotations[enableAuthAnnotation]
+	legacyValue, legacyExist := isvc.Annotations[legacyEnableAuthAnnotation]
+
+	if exist {
+		if _, err := parseEnableAuth(value); err != nil {
+			return warnings, errors.Wrapf(err, "invalid %s annotation", enableAuthAnnotation)
+		}
+	}
+	if legacyExist {
+		if _, err := parseEnableAuth(legacyValue); err != nil {
+			return warnings, errors.Wrapf(err, "invalid %s annotation", legacyEnableAuthAnnotation)
+		}
+	}
+	if exist && legacyExist && !strings.EqualFold(value, legacyValue) {
+		warnings = append(warnings, fmt.Sprintf(
+			"both %s=%q and legacy %s=%q are set with conflicting values; %s takes precedence",
+			enableAuthAnnotation, value, legacyEnableAuthAnnotation, legacyValue, enableAuthAnnotation))
+	}
+
+	// authTypeDetector gives auth-profile precedence over the legacy booleans (same as the
+	// reconciler), so it - not a re-derivation from enableAuthAnnotation/legacyEnableAuthAnnotation
+	// alone - is the source of truth for whether auth is enabled and for a bad auth-profile value.
+	authType, err := v.authTypeDetector.Detect(ctx, isvc)
+	if err != nil {
+		return warnings, errors.Wrap(err, "invalid auth configuration")
+	}
+	if authType == resources.Anonymous {
+		return warnings, nil
+	}
+
+	if err := v.checkAuthorinoInstalled(); err != nil {
+		return warnings, err
+	}
+
+	if len(resources.NewKServeInferenceServiceHostExtractor().Extract(isvc)) == 0 {
+		warnings = append(warnings, fmt.Sprintf(
+			"InferenceService %s/%s has auth enabled but no status URL is populated yet; "+
+				"the AuthConfig host list will be empty until the serving runtime reports a status",
+			isvc.Namespace, isvc.Name))
+	}
+
+	if err := v.checkAuthConfigTemplate(ctx, isvc, authType); err != nil {
+		return warnings, err
+	}
+
+	return warnings, nil
+}
+
+func parseEnableAuth(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("must be \"true\" or \"false\", got %q", value)
+	}
+}
+
+func (v *InferenceServiceValidator) checkAuthorinoInstalled() error {
+	if _, err := v.client.RESTMapper().RESTMapping(authorinoAuthConfigGK); err != nil {
+		if meta.IsNoMatchError(err) || apierrs.IsNotFound(err) {
+			return fmt.Errorf("auth is enabled but the Authorino CRDs are not installed on the cluster (no mapping for %s)", authorinoAuthConfigGK)
+		}
+		return errors.Wrap(err, "could not verify that the Authorino CRDs are installed")
+	}
+	return nil
+}
+
+func (v *InferenceServiceValidator) checkAuthConfigTemplate(ctx context.Context, isvc *kservev1beta1.InferenceService, authType resources.AuthType) error {
+	key := client.ObjectKeyFromObject(isvc)
+	if _, err := v.templateLoader.Load(ctx, authType, key); err != nil {
+		return errors.Wrapf(err, "could not validate %s configmap in namespace %s", "authconfig-template", isvc.Namespace)
+	}
+	return nil
+}